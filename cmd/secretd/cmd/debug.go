@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/spf13/cobra"
+)
+
+// DebugCmd returns the SDK's stock `debug` command tree extended with
+// Secret/EVM-specific address tooling. NewRootCmd mounts this in place of
+// the plain debug.Cmd().
+func DebugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(DebugAddrCmd())
+	return cmd
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/scrtlabs/SecretNetwork/rpc/namespaces/utils"
+)
+
+// DebugAddrCmd prints the bech32, raw hex, and EIP-55 checksummed hex forms
+// of an address, mirroring ethermint's `debug addr` tool. It is mounted
+// under the `debug` command tree by DebugCmd.
+func DebugAddrCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "addr [address]",
+		Short: "Convert an address between bech32 and hex, printing all known representations",
+		Long: `Convert an address between bech32 and hex formats. Accepts either a
+secret1... bech32 address or a 0x-prefixed hex address and prints the
+bech32 form, the raw 20-byte payload as lowercase hex, and the EIP-55
+checksummed hex.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address := args[0]
+
+			api := utils.NewAPI()
+
+			var bech32Addr string
+			var hexAddr common.Address
+			switch {
+			case common.IsHexAddress(address):
+				hexAddr = common.HexToAddress(address)
+				converted, err := api.ConvertAddress(address)
+				if err != nil {
+					return err
+				}
+				bech32Addr = converted
+			default:
+				converted, err := api.ConvertAddress(address)
+				if err != nil {
+					return err
+				}
+				hexAddr = common.HexToAddress(converted)
+				bech32Addr = address
+			}
+
+			cmd.Println("Address:")
+			cmd.Printf("  Bech32:    %s\n", bech32Addr)
+			cmd.Printf("  Hex:       %s\n", fmt.Sprintf("%x", hexAddr.Bytes()))
+			cmd.Printf("  Checksum:  %s\n", hexAddr.Hex())
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewRootCmd constructs the secretd root command. It mounts DebugCmd in
+// place of the SDK's plain debug.Cmd so that `secretd debug addr` is
+// reachable from a running binary.
+func NewRootCmd() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "secretd",
+		Short: "Secret Network App Daemon (server)",
+	}
+
+	rootCmd.AddCommand(
+		DebugCmd(),
+	)
+
+	return rootCmd
+}
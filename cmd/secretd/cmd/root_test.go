@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugAddrCmdRegistered(t *testing.T) {
+	rootCmd := NewRootCmd()
+
+	found, _, err := rootCmd.Find([]string{"debug", "addr"})
+	require.NoError(t, err)
+	require.Equal(t, "addr", found.Name())
+}
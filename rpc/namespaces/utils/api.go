@@ -2,9 +2,8 @@ package utils
 
 import (
 	"fmt"
-	"strings"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/scrtlabs/SecretNetwork/types"
 )
@@ -16,19 +15,123 @@ func NewAPI() *API {
 	return &API{}
 }
 
+// AddressKind selects which Secret bech32 prefix family an address belongs
+// to: account, validator operator, or validator consensus.
+//
+// A pubkey-prefix variant (secretpub/secretvaloperpub/secretvalconspub) was
+// attempted here and dropped: those bech32 strings wrap an amino-encoded
+// pubkey, not raw bytes, so naively bech32-encoding raw key bytes under
+// those prefixes does not round-trip with what `keys show --pubkey` or
+// staking/governance tooling actually produces. Add it back once it goes
+// through the SDK's pubkey (un)marshaling and has a real test vector.
+type AddressKind int
+
+const (
+	AddressKindAcc AddressKind = iota
+	AddressKindVal
+	AddressKindCons
+)
+
+// addrCodecs maps each AddressKind to the Bech32Codec for its prefix.
+var addrCodecs = map[AddressKind]Bech32Codec{
+	AddressKindAcc:  NewBech32Codec(types.Bech32PrefixAccAddr),
+	AddressKindVal:  NewBech32Codec(types.Bech32PrefixValAddr),
+	AddressKindCons: NewBech32Codec(types.Bech32PrefixConsAddr),
+}
+
 // ConvertAddress converts provided address from bech32 format to hex
-// and vice versa
+// and vice versa. Bech32 addresses may use the account, validator operator,
+// or validator consensus prefix.
 func (a *API) ConvertAddress(address string) (string, error) {
 	switch {
 	case common.IsHexAddress(address):
 		addrBytes := common.HexToAddress(address).Bytes()
-		convertedAddr := sdk.AccAddress(addrBytes)
-		return convertedAddr.String(), nil
-	case strings.HasPrefix(address, types.Bech32PrefixAccAddr):
-		addrBytes, _ := sdk.AccAddressFromBech32(address)
-		convertedAddr := common.BytesToAddress(addrBytes)
-		return convertedAddr.String(), nil
+		return addrCodecs[AddressKindAcc].BytesToString(addrBytes)
 	default:
-		return "", fmt.Errorf("expected a valid hex or bech32 address")
+		_, addrBytes, err := decodeKnownBech32(address)
+		if err != nil {
+			return "", err
+		}
+		return common.BytesToAddress(addrBytes).String(), nil
+	}
+}
+
+// ConvertAddresses converts a batch of addresses in one call, returning the
+// converted form (or "" on failure) alongside a matching error for each
+// input. Useful for indexers translating whole blocks of events at once.
+func (a *API) ConvertAddresses(addrs []string) ([]string, []error) {
+	converted := make([]string, len(addrs))
+	errs := make([]error, len(addrs))
+	for i, addr := range addrs {
+		converted[i], errs[i] = a.ConvertAddress(addr)
+	}
+	return converted, errs
+}
+
+// Validate reports whether address is a well-formed hex or bech32 address,
+// returning a structured *AddrValidationError on failure.
+func (a *API) Validate(address string) error {
+	if common.IsHexAddress(address) {
+		return nil
+	}
+	_, _, err := decodeKnownBech32(address)
+	return err
+}
+
+// ConvertHexToBech32 converts a hex address to the bech32 form used by the
+// given AddressKind (account, validator operator, or validator consensus).
+func (a *API) ConvertHexToBech32(hexStr string, kind AddressKind) (string, error) {
+	codec, ok := addrCodecs[kind]
+	if !ok {
+		return "", fmt.Errorf("unknown address kind %d", kind)
 	}
+	if !common.IsHexAddress(hexStr) {
+		return "", fmt.Errorf("expected a valid hex address")
+	}
+	addrBytes := common.HexToAddress(hexStr).Bytes()
+	return codec.BytesToString(addrBytes)
+}
+
+// decodeKnownBech32 decodes address against every known AddressKind's
+// codec, returning the matching kind and raw bytes. It fails with
+// ErrWrongPrefix if the address decodes but its prefix isn't one of ours.
+func decodeKnownBech32(address string) (AddressKind, []byte, error) {
+	hrp, addrBytes, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return 0, nil, newAddrError(ErrUnknownFormat, err)
+	}
+	for kind, codec := range addrCodecs {
+		if codec.prefix == hrp {
+			return kind, addrBytes, nil
+		}
+	}
+	return 0, nil, newAddrError(ErrWrongPrefix, fmt.Errorf("unrecognized bech32 prefix %q", hrp))
+}
+
+// ConvertAddressChecksummed converts provided address from bech32 format to
+// EIP-55 checksummed hex and vice versa. When strict is true and address is
+// already hex, the casing must match the EIP-55 checksum or an error is
+// returned instead of silently normalizing it.
+func (a *API) ConvertAddressChecksummed(address string, strict bool) (string, error) {
+	switch {
+	case common.IsHexAddress(address):
+		if strict && !isEIP55Checksummed(address) {
+			return "", fmt.Errorf("address %s is not EIP-55 checksummed", address)
+		}
+		convertedAddr := common.HexToAddress(address)
+		return convertedAddr.Hex(), nil
+	default:
+		_, addrBytes, err := decodeKnownBech32(address)
+		if err != nil {
+			return "", err
+		}
+		return common.BytesToAddress(addrBytes).Hex(), nil
+	}
+}
+
+// isEIP55Checksummed reports whether addr is hex-encoded using the mixed-case
+// checksum defined by EIP-55. addr must already satisfy common.IsHexAddress;
+// an optional 0x/0X prefix is not part of the checksum.
+func isEIP55Checksummed(addr string) bool {
+	return common.HexToAddress(addr).Hex()[2:] == addr[len(addr)-2*common.AddressLength:]
 }
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/types"
+)
+
+func TestConvertAddressRoundTrip(t *testing.T) {
+	api := NewAPI()
+	hexAddr := "0x1234567890123456789012345678901234567890"
+
+	bech32Addr, err := api.ConvertAddress(hexAddr)
+	require.NoError(t, err)
+
+	roundTripped, err := api.ConvertAddress(bech32Addr)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress(hexAddr).Hex(), roundTripped)
+}
+
+func TestConvertAddressValidatorAndConsensusPrefixes(t *testing.T) {
+	api := NewAPI()
+	hexAddr := "0xabCDEF1234567890abcdef1234567890aBcDeF12"
+
+	bech32Val, err := api.ConvertHexToBech32(hexAddr, AddressKindVal)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(bech32Val, types.Bech32PrefixValAddr))
+
+	converted, err := api.ConvertAddress(bech32Val)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress(hexAddr).Hex(), converted)
+
+	bech32Cons, err := api.ConvertHexToBech32(hexAddr, AddressKindCons)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(bech32Cons, types.Bech32PrefixConsAddr))
+}
+
+func TestConvertAddressesPartialFailure(t *testing.T) {
+	api := NewAPI()
+	valid := "0x1234567890123456789012345678901234567890"
+
+	validBech32, err := api.ConvertAddress(valid)
+	require.NoError(t, err)
+
+	results, errs := api.ConvertAddresses([]string{validBech32, "not-an-address"})
+	require.Len(t, results, 2)
+	require.Len(t, errs, 2)
+
+	require.NoError(t, errs[0])
+	require.Equal(t, common.HexToAddress(valid).Hex(), results[0])
+
+	require.Error(t, errs[1])
+	require.Empty(t, results[1])
+}
+
+// TestConvertAddressChecksummed uses the well-known address for secp256k1
+// private key 1 (generator point G), whose EIP-55 checksummed form is
+// 0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf.
+func TestConvertAddressChecksummed(t *testing.T) {
+	const (
+		checksummed = "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"
+		lowercase   = "0x7e5f4552091a69125d5dfcb7b8c2659029395bdf"
+		bech32Addr  = "secret10e0525sfrf53yh2aljmm3sn9jq5njk7lx9c0ad"
+	)
+
+	api := NewAPI()
+
+	// strict=true rejects a hex address that isn't EIP-55 checksummed.
+	_, err := api.ConvertAddressChecksummed(lowercase, true)
+	require.Error(t, err)
+
+	// strict=true accepts the correctly checksummed form.
+	got, err := api.ConvertAddressChecksummed(checksummed, true)
+	require.NoError(t, err)
+	require.Equal(t, checksummed, got)
+
+	// strict=false normalizes a mis-cased hex address instead of rejecting it.
+	got, err = api.ConvertAddressChecksummed(lowercase, false)
+	require.NoError(t, err)
+	require.Equal(t, checksummed, got)
+
+	// bech32 -> checksummed hex.
+	got, err = api.ConvertAddressChecksummed(bech32Addr, false)
+	require.NoError(t, err)
+	require.Equal(t, checksummed, got)
+}
+
+func TestValidate(t *testing.T) {
+	api := NewAPI()
+	require.NoError(t, api.Validate("0x1234567890123456789012345678901234567890"))
+
+	err := api.Validate("not-an-address")
+	require.Error(t, err)
+
+	var addrErr *AddrValidationError
+	require.ErrorAs(t, err, &addrErr)
+}
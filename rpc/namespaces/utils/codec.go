@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// Bech32Codec encodes and decodes addresses for a single bech32 prefix,
+// analogous to the SDK's codec/address.Bech32Codec.
+type Bech32Codec struct {
+	prefix string
+}
+
+// NewBech32Codec returns a Bech32Codec for the given human-readable prefix.
+func NewBech32Codec(prefix string) Bech32Codec {
+	return Bech32Codec{prefix: prefix}
+}
+
+// BytesToString encodes raw address bytes into this codec's bech32 form.
+func (bc Bech32Codec) BytesToString(bz []byte) (string, error) {
+	return bech32.ConvertAndEncode(bc.prefix, bz)
+}
+
+// StringToBytes decodes a bech32 address, verifying that it uses this
+// codec's prefix.
+func (bc Bech32Codec) StringToBytes(text string) ([]byte, error) {
+	hrp, bz, err := bech32.DecodeAndConvert(text)
+	if err != nil {
+		return nil, newAddrError(ErrUnknownFormat, err)
+	}
+	if hrp != bc.prefix {
+		return nil, newAddrError(ErrWrongPrefix, fmt.Errorf("expected prefix %q, got %q", bc.prefix, hrp))
+	}
+	return bz, nil
+}
+
+// Validate reports whether text is a well-formed bech32 address for this
+// codec's prefix.
+func (bc Bech32Codec) Validate(text string) error {
+	_, err := bc.StringToBytes(text)
+	return err
+}
+
+// MustFromString is like StringToBytes but panics on error. It is intended
+// for tests and genesis fixtures where the address is known to be valid.
+func (bc Bech32Codec) MustFromString(text string) []byte {
+	bz, err := bc.StringToBytes(text)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// AddrErrorKind classifies why an address failed validation.
+type AddrErrorKind int
+
+const (
+	ErrUnknownFormat AddrErrorKind = iota
+	ErrBadLength
+	ErrBadChecksum
+	ErrWrongPrefix
+)
+
+// AddrValidationError is a structured error returned by Validate, letting
+// callers branch on the failure Kind instead of matching error strings.
+type AddrValidationError struct {
+	Kind AddrErrorKind
+	Err  error
+}
+
+func (e *AddrValidationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AddrValidationError) Unwrap() error {
+	return e.Err
+}
+
+// newAddrError classifies err's underlying bech32 complaint into an
+// AddrErrorKind on a best-effort basis, since the upstream bech32 package
+// only exposes error strings.
+func newAddrError(kind AddrErrorKind, err error) *AddrValidationError {
+	if kind == ErrUnknownFormat {
+		switch {
+		case strings.Contains(err.Error(), "checksum"):
+			kind = ErrBadChecksum
+		case strings.Contains(err.Error(), "length") || strings.Contains(err.Error(), "index"):
+			kind = ErrBadLength
+		}
+	}
+	return &AddrValidationError{Kind: kind, Err: err}
+}
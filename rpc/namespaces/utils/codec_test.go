@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBech32CodecRoundTrip(t *testing.T) {
+	codec := NewBech32Codec("secret")
+	addr := bytes.Repeat([]byte{0x01}, 20)
+
+	encoded, err := codec.BytesToString(addr)
+	require.NoError(t, err)
+
+	decoded, err := codec.StringToBytes(encoded)
+	require.NoError(t, err)
+	require.Equal(t, addr, decoded)
+}
+
+func TestBech32CodecWrongPrefix(t *testing.T) {
+	accCodec := NewBech32Codec("secret")
+	valCodec := NewBech32Codec("secretvaloper")
+	addr := bytes.Repeat([]byte{0x02}, 20)
+
+	encoded, err := accCodec.BytesToString(addr)
+	require.NoError(t, err)
+
+	_, err = valCodec.StringToBytes(encoded)
+	require.Error(t, err)
+
+	var addrErr *AddrValidationError
+	require.ErrorAs(t, err, &addrErr)
+	require.Equal(t, ErrWrongPrefix, addrErr.Kind)
+}
+
+func TestBech32CodecValidate(t *testing.T) {
+	codec := NewBech32Codec("secret")
+	addr := bytes.Repeat([]byte{0x03}, 20)
+
+	encoded, err := codec.BytesToString(addr)
+	require.NoError(t, err)
+	require.NoError(t, codec.Validate(encoded))
+
+	require.Error(t, codec.Validate("not-a-bech32-address"))
+}
+
+func TestBech32CodecMustFromString(t *testing.T) {
+	codec := NewBech32Codec("secret")
+	addr := bytes.Repeat([]byte{0x04}, 20)
+
+	encoded, err := codec.BytesToString(addr)
+	require.NoError(t, err)
+
+	require.NotPanics(t, func() {
+		require.Equal(t, addr, codec.MustFromString(encoded))
+	})
+	require.Panics(t, func() {
+		codec.MustFromString("not-a-bech32-address")
+	})
+}
+
+func TestNewAddrErrorClassification(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want AddrErrorKind
+	}{
+		{"checksum", errors.New("invalid checksum (expected abc got xyz)"), ErrBadChecksum},
+		{"string length", errors.New("invalid bech32 string length 3"), ErrBadLength},
+		{"separator index", errors.New("invalid separator index -1"), ErrBadLength},
+		{"other", errors.New("invalid character in string: '!'"), ErrUnknownFormat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := newAddrError(ErrUnknownFormat, tc.err)
+			require.Equal(t, tc.want, got.Kind)
+			require.Equal(t, tc.err, got.Unwrap())
+		})
+	}
+}
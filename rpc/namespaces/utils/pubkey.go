@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// FormatHash normalizes a 32-byte hash given as hex, with or without a `0x`
+// prefix, into a lowercase 0x-prefixed common.Hash string.
+func (a *API) FormatHash(key string) (string, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("invalid hex: %w", err)
+	}
+	if len(bz) != common.HashLength {
+		return "", fmt.Errorf("expected a %d-byte hash, got %d bytes", common.HashLength, len(bz))
+	}
+	return common.BytesToHash(bz).Hex(), nil
+}
+
+// PubKeyToAddresses derives the Secret account address from an uncompressed
+// secp256k1 public key, as retrieved from `keys show --pubkey` or a JSON-RPC
+// transaction signature. It returns both the secret1... bech32 form and the
+// EIP-55 checksummed hex form.
+func (a *API) PubKeyToAddresses(pubkeyHex string) (bech32Addr, hexAddr string, err error) {
+	pubkeyHex = strings.TrimPrefix(pubkeyHex, "0x")
+	pubkeyBytes, err := hex.DecodeString(pubkeyHex)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid hex: %w", err)
+	}
+
+	// Uncompressed secp256k1 public keys are 65 bytes: a leading 0x04 prefix
+	// followed by the 32-byte X and Y coordinates.
+	if len(pubkeyBytes) == 65 && pubkeyBytes[0] == 0x04 {
+		pubkeyBytes = pubkeyBytes[1:]
+	}
+	if len(pubkeyBytes) != 64 {
+		return "", "", fmt.Errorf("expected a 64 or 65-byte uncompressed secp256k1 public key, got %d bytes", len(pubkeyBytes))
+	}
+
+	addrBytes := crypto.Keccak256(pubkeyBytes)[12:]
+
+	bech32Addr, err = addrCodecs[AddressKindAcc].BytesToString(addrBytes)
+	if err != nil {
+		return "", "", err
+	}
+	hexAddr = common.BytesToAddress(addrBytes).Hex()
+
+	return bech32Addr, hexAddr, nil
+}
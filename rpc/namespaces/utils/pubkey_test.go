@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Known answer test: the Keccak-256 hash of the empty byte string is a
+// widely cited constant (it is Ethereum's EmptyCodeHash).
+const knownEmptyKeccak256 = "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"
+
+func TestFormatHash(t *testing.T) {
+	api := NewAPI()
+
+	withPrefix, err := api.FormatHash("0x" + knownEmptyKeccak256)
+	require.NoError(t, err)
+	require.Equal(t, "0x"+knownEmptyKeccak256, withPrefix)
+
+	withoutPrefix, err := api.FormatHash(knownEmptyKeccak256)
+	require.NoError(t, err)
+	require.Equal(t, "0x"+knownEmptyKeccak256, withoutPrefix)
+
+	_, err = api.FormatHash("0x1234")
+	require.Error(t, err)
+
+	_, err = api.FormatHash("not-hex")
+	require.Error(t, err)
+}
+
+// TestPubKeyToAddresses uses the secp256k1 generator point G as its
+// uncompressed public key, which is the public key for the (famously
+// insecure) private key 1. Its Keccak-256-derived address is a widely
+// cited known answer: 0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf.
+func TestPubKeyToAddresses(t *testing.T) {
+	api := NewAPI()
+
+	const (
+		uncompressedGeneratorPubkey = "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+			"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+		wantHex    = "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"
+		wantBech32 = "secret10e0525sfrf53yh2aljmm3sn9jq5njk7lx9c0ad"
+	)
+
+	bech32Addr, hexAddr, err := api.PubKeyToAddresses(uncompressedGeneratorPubkey)
+	require.NoError(t, err)
+	require.Equal(t, wantHex, hexAddr)
+	require.Equal(t, wantBech32, bech32Addr)
+
+	// Stripping the 0x04 prefix should derive the same address.
+	bech32Addr2, hexAddr2, err := api.PubKeyToAddresses(uncompressedGeneratorPubkey[2:])
+	require.NoError(t, err)
+	require.Equal(t, wantHex, hexAddr2)
+	require.Equal(t, wantBech32, bech32Addr2)
+
+	_, _, err = api.PubKeyToAddresses("0x1234")
+	require.Error(t, err)
+}